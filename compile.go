@@ -2,6 +2,7 @@ package go_rego
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -14,6 +15,32 @@ type ConvertConfig struct {
 	// VariableConverter is called each time a var is encountered. This creates
 	// the SQL ast for the variable.
 	VariableConverter sqlast.VariableMatcher
+
+	// Parameterized, when true, causes CompileSQL to emit literal values as
+	// ordered placeholders ($1, $2, ...) instead of inlining them into the
+	// SQL text. See sqlast.SQLGenerator.Parameterized.
+	Parameterized bool
+}
+
+// CompileSQL converts a rego partial evaluation result directly into a SQL
+// string (and, when cfg.Parameterized is set, the ordered argument list for
+// the placeholders within it). This is a thin wrapper around
+// ConvertRegoAst + sqlast.BooleanNode.SQLString for callers who don't need
+// the intermediate sqlast.BooleanNode.
+func CompileSQL(cfg ConvertConfig, partial *rego.PartialQueries) (string, []any, error) {
+	node, err := ConvertRegoAst(cfg, partial)
+	if err != nil {
+		return "", nil, err
+	}
+
+	gen := sqlast.NewSQLGenerator()
+	gen.Parameterized = cfg.Parameterized
+	sql := node.SQLString(gen)
+	if errs := gen.Errors(); len(errs) > 0 {
+		return "", nil, errors.Join(errs...)
+	}
+
+	return sql, gen.Args, nil
 }
 
 func NoACLConverter() *sqlast.VariableConverter {
@@ -144,6 +171,7 @@ func convertCall(cfg ConvertConfig, call ast.Call) (sqlast.Node, error) {
 		args = call[1:]
 	}
 
+	source := sqlast.RegoSource(ast.Call(call).String())
 	opString := op.String()
 	switch op.String() {
 	case "neq", "eq", "equals", "equal":
@@ -157,7 +185,7 @@ func convertCall(cfg ConvertConfig, call ast.Call) (sqlast.Node, error) {
 			not = true
 		}
 
-		return sqlast.Equality(not, args[0], args[1]), nil
+		return sqlast.Equality(source, op.Location, not, args[0], args[1]), nil
 	case "internal.member_2":
 		args, err := convertTerms(cfg, args, 2)
 		if err != nil {
@@ -165,8 +193,47 @@ func convertCall(cfg ConvertConfig, call ast.Call) (sqlast.Node, error) {
 		}
 
 		return sqlast.MemberOf(args[0], args[1]), nil
+	case "lt", "lte", "gt", "gte":
+		args, err := convertTerms(cfg, args, 2)
+		if err != nil {
+			return nil, fmt.Errorf("arguments: %w", err)
+		}
+
+		return sqlast.Ordering(source, op.Location, orderingOp(opString), args[0], args[1]), nil
+	case "internal.compare":
+		// internal.compare(a, b) is how the partial evaluator sometimes
+		// lowers a three-way comparison (returning -1, 0, or 1) rather than
+		// emitting lt/lte/gt/gte directly. It has no boolean result to hand
+		// back here, and it is not converted anywhere else either: it is an
+		// error wherever it appears, including as an operand of lt/lte/gt/gte.
+		return nil, sqlast.CompileError{
+			Source:      source,
+			OPALocation: op.Location,
+			Kind:        sqlast.KindUnsupported,
+			Underlying:  fmt.Errorf("internal.compare not supported"),
+		}
+	default:
+		return nil, sqlast.CompileError{
+			Source:      source,
+			OPALocation: op.Location,
+			Kind:        sqlast.KindUnsupported,
+			Underlying:  fmt.Errorf("operator %s not supported", op),
+		}
+	}
+}
+
+func orderingOp(opString string) sqlast.OrderingOp {
+	switch opString {
+	case "lt":
+		return sqlast.OrderingLessThan
+	case "lte":
+		return sqlast.OrderingLessThanOrEqual
+	case "gt":
+		return sqlast.OrderingGreaterThan
+	case "gte":
+		return sqlast.OrderingGreaterThanOrEqual
 	default:
-		return nil, fmt.Errorf("operator %s not supported", op)
+		return ""
 	}
 }
 
@@ -191,16 +258,26 @@ func convertTerm(cfg ConvertConfig, term *ast.Term) (sqlast.Node, error) {
 	source := sqlast.RegoSource(term.String())
 	switch t := term.Value.(type) {
 	case ast.Var:
-		return nil, fmt.Errorf("var not yet supported")
+		return sqlast.Var(source, term.Location, string(t)), nil
 	case ast.Ref:
 		if len(t) == 0 {
 			// A reference with no text is a variable with no name?
 			// This makes no sense.
-			return nil, fmt.Errorf("empty ref not supported")
+			return nil, sqlast.CompileError{
+				Source:      source,
+				OPALocation: term.Location,
+				Kind:        sqlast.KindUnsupported,
+				Underlying:  fmt.Errorf("empty ref not supported"),
+			}
 		}
 
 		if cfg.VariableConverter == nil {
-			return nil, fmt.Errorf("no variable converter provided to handle variables")
+			return nil, sqlast.CompileError{
+				Source:      source,
+				OPALocation: term.Location,
+				Kind:        sqlast.KindUnsupported,
+				Underlying:  fmt.Errorf("no variable converter provided to handle variables"),
+			}
 		}
 
 		// The structure of references is as follows:
@@ -212,7 +289,12 @@ func convertTerm(cfg ConvertConfig, term *ast.Term) (sqlast.Node, error) {
 		// 3. Repeat 1-2 until the end of the reference.
 		node, ok := cfg.VariableConverter.ConvertVariable(t)
 		if !ok {
-			return nil, fmt.Errorf("variable %q cannot be converted", t.String())
+			return nil, sqlast.CompileError{
+				Source:      source,
+				OPALocation: term.Location,
+				Kind:        sqlast.KindUnknownVariable,
+				Underlying:  fmt.Errorf("variable %q cannot be converted", t.String()),
+			}
 		}
 		return node, nil
 	case ast.String:
@@ -232,7 +314,31 @@ func convertTerm(cfg ConvertConfig, term *ast.Term) (sqlast.Node, error) {
 		}
 		return sqlast.Array(source, elems...)
 	case ast.Object:
-		return nil, fmt.Errorf("object not yet supported")
+		entries := make([]sqlast.ObjectEntry, 0, t.Len())
+		var entryErr error
+		t.Foreach(func(k, v *ast.Term) {
+			if entryErr != nil {
+				return
+			}
+
+			key, err := convertTerm(cfg, k)
+			if err != nil {
+				entryErr = fmt.Errorf("object key %s in %q: %w", k.String(), term.String(), err)
+				return
+			}
+
+			value, err := convertTerm(cfg, v)
+			if err != nil {
+				entryErr = fmt.Errorf("object value %s in %q: %w", v.String(), term.String(), err)
+				return
+			}
+
+			entries = append(entries, sqlast.ObjectEntry{Key: key, Value: value})
+		})
+		if entryErr != nil {
+			return nil, entryErr
+		}
+		return sqlast.Object(source, term.Location, entries...), nil
 	case ast.Set:
 		// Just treat a set like an array for now.
 		arr := t.Sorted()
@@ -244,6 +350,11 @@ func convertTerm(cfg ConvertConfig, term *ast.Term) (sqlast.Node, error) {
 		// This is a function call
 		return convertCall(cfg, t)
 	default:
-		return nil, fmt.Errorf("%T not yet supported", t)
+		return nil, sqlast.CompileError{
+			Source:      source,
+			OPALocation: term.Location,
+			Kind:        sqlast.KindUnsupported,
+			Underlying:  fmt.Errorf("%T not yet supported", t),
+		}
 	}
 }
@@ -0,0 +1,72 @@
+package go_rego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Emyrk/go-rego/sqlast"
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// Prepared is a compiled, reusable predicate produced by Prepare. It
+// decouples the expensive partial-eval + AST conversion pass from per-request
+// use: callers prepare once per (role, action, objectType) and then reuse the
+// result to both filter rows in SQL (SQL, WhereClause) and check a single
+// in-memory object (Matches), guaranteeing the two code paths agree.
+type Prepared struct {
+	node sqlast.BooleanNode
+	sql  string
+	args []any
+}
+
+// Prepare runs rego partial evaluation once against policy/query/unknowns
+// and converts the result into a reusable Prepared predicate.
+func Prepare(ctx context.Context, cfg ConvertConfig, policy, query string, unknowns []string, input map[string]interface{}) (*Prepared, error) {
+	part, err := rego.New(
+		rego.Query(query),
+		rego.Module("policy.rego", policy),
+		rego.Input(input),
+		rego.Unknowns(unknowns),
+	).Partial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("partial eval: %w", err)
+	}
+
+	node, err := ConvertRegoAst(cfg, part)
+	if err != nil {
+		return nil, fmt.Errorf("convert: %w", err)
+	}
+
+	gen := sqlast.NewSQLGenerator()
+	gen.Parameterized = cfg.Parameterized
+	sql := node.SQLString(gen)
+	if errs := gen.Errors(); len(errs) > 0 {
+		return nil, fmt.Errorf("generate sql: %w", errors.Join(errs...))
+	}
+
+	return &Prepared{node: node, sql: sql, args: gen.Args}, nil
+}
+
+// SQL returns the compiled predicate as a SQL boolean expression, along with
+// its ordered placeholder arguments when the Prepared was built with
+// ConvertConfig.Parameterized set.
+func (p *Prepared) SQL() (string, []any) {
+	return p.sql, p.args
+}
+
+// WhereClause returns the compiled predicate as a ready-to-append WHERE
+// clause, e.g. `"SELECT * FROM " + table + " " + p.WhereClause(table)`. table
+// is accepted for forward compatibility with multi-table predicates that
+// need to qualify ambiguous column names; the current converters don't use
+// it yet.
+func (p *Prepared) WhereClause(table string) string {
+	return "WHERE " + p.sql
+}
+
+// Matches evaluates the compiled predicate against a single in-memory
+// object, using the exact same sqlast.BooleanNode that SQL/WhereClause
+// render, so the two code paths can't disagree.
+func (p *Prepared) Matches(obj map[string]any) (bool, error) {
+	return sqlast.Match(p.node, obj)
+}
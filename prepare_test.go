@@ -0,0 +1,50 @@
+package go_rego
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Emyrk/go-rego/sqlast"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPreparedMatches exercises the full Prepare -> SQL/Matches path end to
+// end, rather than just unit testing sqlast in isolation. ConvertRegoAst
+// wraps any non-constant result in sqlast.Or/And, so this is also the test
+// that would have caught boolCombinator not implementing SupportsMatch.
+func TestPreparedMatches(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := ConvertConfig{
+		VariableConverter: sqlast.NewVariableConverter().RegisterMatcher(
+			sqlast.StringVarMatcher("author", []string{"input", "post", "author"}),
+		),
+	}
+
+	policy := `
+	package example
+	allow {
+		input.post.author == input.user
+	}
+	`
+
+	prepared, err := Prepare(ctx, cfg, policy, "data.example.allow == true",
+		[]string{"input.post.author"}, map[string]interface{}{
+			"user": "bob",
+		})
+	require.NoError(t, err)
+
+	sql, args := prepared.SQL()
+	require.Equal(t, "author = 'bob'", sql)
+	require.Empty(t, args)
+
+	require.Equal(t, "WHERE author = 'bob'", prepared.WhereClause("posts"))
+
+	matches, err := prepared.Matches(map[string]any{"author": "bob"})
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = prepared.Matches(map[string]any{"author": "alice"})
+	require.NoError(t, err)
+	require.False(t, matches)
+}
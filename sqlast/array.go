@@ -0,0 +1,90 @@
+package sqlast
+
+import "fmt"
+
+var _ Node = AstArray{}
+var _ SupportsMatch = AstArray{}
+
+// AstArray represents a literal array/set term, e.g. ["edit", "*"].
+type AstArray struct {
+	Source RegoSource
+	Elems  []Node
+}
+
+// Array returns a Node rendering elems as a literal array. It returns an
+// error for symmetry with the other term converters in convertTerm, though
+// today it can never fail.
+func Array(source RegoSource, elems ...Node) (Node, error) {
+	return AstArray{Source: source, Elems: elems}, nil
+}
+
+func (AstArray) UseAs() Node { return AstArray{} }
+
+func (a AstArray) SQLString(cfg *SQLGenerator) string {
+	// Each element routes through its own SQLString, so literal elements
+	// (AstString, AstNumber, ...) register themselves with cfg.Placeholder
+	// exactly as they would outside of an array.
+	parts := make([]string, 0, len(a.Elems))
+	for _, e := range a.Elems {
+		parts = append(parts, e.SQLString(cfg))
+	}
+	return cfg.Dialect.ArrayLiteral(parts)
+}
+
+func (a AstArray) MatchValue(obj map[string]any) (any, error) {
+	vals := make([]any, 0, len(a.Elems))
+	for i, e := range a.Elems {
+		v, err := matchValue(e, obj)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
+
+var _ BooleanNode = memberOf{}
+var _ SupportsMatch = memberOf{}
+
+// memberOf represents `elem in arr` (Rego's internal.member_2), e.g.
+// `input.can in ["edit", "*"]`.
+type memberOf struct {
+	Elem Node
+	Arr  Node
+}
+
+// MemberOf returns a BooleanNode checking whether elem is a member of arr.
+func MemberOf(elem, arr Node) BooleanNode {
+	return memberOf{Elem: elem, Arr: arr}
+}
+
+func (memberOf) IsBooleanNode() {}
+func (memberOf) UseAs() Node    { return memberOf{} }
+
+func (m memberOf) SQLString(cfg *SQLGenerator) string {
+	return cfg.Dialect.MemberOf(m.Elem.SQLString(cfg), m.Arr.SQLString(cfg))
+}
+
+func (m memberOf) MatchValue(obj map[string]any) (any, error) {
+	elem, err := matchValue(m.Elem, obj)
+	if err != nil {
+		return nil, fmt.Errorf("elem: %w", err)
+	}
+
+	arrVal, err := matchValue(m.Arr, obj)
+	if err != nil {
+		return nil, fmt.Errorf("array: %w", err)
+	}
+
+	arr, ok := arrVal.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an array to check membership against, got %T", arrVal)
+	}
+
+	for _, v := range arr {
+		if fmt.Sprint(v) == fmt.Sprint(elem) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,57 @@
+package sqlast
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/ast"
+)
+
+// ErrorKind classifies why a CompileError occurred, so callers can
+// distinguish "we just haven't implemented this yet" from "the policy
+// itself doesn't make sense for SQL".
+type ErrorKind int
+
+const (
+	KindUnsupported ErrorKind = iota
+	KindTypeMismatch
+	KindUnknownVariable
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case KindUnsupported:
+		return "Unsupported"
+	case KindTypeMismatch:
+		return "TypeMismatch"
+	case KindUnknownVariable:
+		return "UnknownVariable"
+	default:
+		return "Unknown"
+	}
+}
+
+// CompileError is a single failure encountered while converting a rego
+// partial-eval result into sqlast, or while rendering sqlast into SQL. It
+// always carries enough context to point back at the offending expression
+// in the original policy, so callers can surface an actionable diagnostic
+// instead of a bare "unsupported" string.
+type CompileError struct {
+	// Source is the Rego expression or term that failed to convert.
+	Source RegoSource
+	// OPALocation is the source location of the term/expression within the
+	// original Rego module, when one was available at the point of failure.
+	OPALocation *ast.Location
+	Kind        ErrorKind
+	Underlying  error
+}
+
+func (e CompileError) Error() string {
+	if e.OPALocation != nil {
+		return fmt.Sprintf("%s: [%s] %s: %s", e.OPALocation.String(), e.Kind, e.Source, e.Underlying)
+	}
+	return fmt.Sprintf("[%s] %s: %s", e.Kind, e.Source, e.Underlying)
+}
+
+func (e CompileError) Unwrap() error {
+	return e.Underlying
+}
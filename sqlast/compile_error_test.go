@@ -0,0 +1,50 @@
+package sqlast
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileErrorStringWithLocation(t *testing.T) {
+	err := CompileError{
+		Source:      "input.post.author == input.user",
+		OPALocation: &ast.Location{Row: 3, Col: 5},
+		Kind:        KindUnsupported,
+		Underlying:  errors.New("unsupported equality: AstArray == AstBoolean"),
+	}
+
+	msg := err.Error()
+	require.Contains(t, msg, "Unsupported")
+	require.Contains(t, msg, "input.post.author == input.user")
+	require.Contains(t, msg, "unsupported equality: AstArray == AstBoolean")
+	require.Equal(t, "3:5: [Unsupported] input.post.author == input.user: unsupported equality: AstArray == AstBoolean", msg)
+}
+
+func TestCompileErrorStringWithoutLocation(t *testing.T) {
+	err := CompileError{
+		Source:     "input.x",
+		Kind:       KindUnknownVariable,
+		Underlying: errors.New("no matcher registered for ref"),
+	}
+
+	msg := err.Error()
+	require.Contains(t, msg, "UnknownVariable")
+	require.Equal(t, "[UnknownVariable] input.x: no matcher registered for ref", msg)
+}
+
+func TestCompileErrorUnwrap(t *testing.T) {
+	underlying := errors.New("boom")
+	err := CompileError{Kind: KindTypeMismatch, Underlying: underlying}
+
+	require.ErrorIs(t, err, underlying)
+}
+
+func TestErrorKindString(t *testing.T) {
+	require.Equal(t, "Unsupported", KindUnsupported.String())
+	require.Equal(t, "TypeMismatch", KindTypeMismatch.String())
+	require.Equal(t, "UnknownVariable", KindUnknownVariable.String())
+	require.Equal(t, "Unknown", ErrorKind(99).String())
+}
@@ -0,0 +1,140 @@
+package sqlast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RegoSource preserves the original Rego expression text a sqlast node was
+// converted from, purely for diagnostics (see CompileError).
+type RegoSource string
+
+// Node is anything that can render itself as a SQL expression.
+type Node interface {
+	// UseAs returns a zero-value instance of the node's concrete type. Other
+	// nodes type-switch on this to ask "what kind of node is this?" without
+	// caring about its contents, e.g. `switch other.UseAs().(type)`.
+	UseAs() Node
+	SQLString(cfg *SQLGenerator) string
+}
+
+// BooleanNode is a Node guaranteed to render to a SQL boolean expression.
+type BooleanNode interface {
+	Node
+	IsBooleanNode()
+}
+
+var _ Node = AstBoolean{}
+var _ BooleanNode = AstBoolean{}
+var _ SupportsEquality = AstBoolean{}
+var _ SupportsMatch = AstBoolean{}
+
+// AstBoolean represents a literal true/false term.
+type AstBoolean struct {
+	Value bool
+}
+
+func Bool(v bool) BooleanNode {
+	return AstBoolean{Value: v}
+}
+
+func (AstBoolean) IsBooleanNode() {}
+func (AstBoolean) UseAs() Node    { return AstBoolean{} }
+
+func (b AstBoolean) SQLString(cfg *SQLGenerator) string {
+	if b.Value {
+		return "true"
+	}
+	return "false"
+}
+
+func (b AstBoolean) EqualsSQLString(cfg *SQLGenerator, not bool, other Node) (string, error) {
+	switch other.UseAs().(type) {
+	case AstBoolean:
+		return basicSQLEquality(cfg, not, b, other), nil
+	}
+
+	return "", fmt.Errorf("unsupported equality: %T %s %T", b, equalsOp(not), other)
+}
+
+func (b AstBoolean) MatchValue(obj map[string]any) (any, error) {
+	return b.Value, nil
+}
+
+type combinatorOp string
+
+const (
+	combinatorAnd combinatorOp = "AND"
+	combinatorOr  combinatorOp = "OR"
+)
+
+var _ BooleanNode = boolCombinator{}
+var _ SupportsMatch = boolCombinator{}
+
+// boolCombinator ANDs or ORs together a list of boolean expressions. This is
+// what And and Or both build; ConvertRegoAst wraps every converted predicate
+// in these (an Or across queries, each an And across a query's expressions),
+// so it's on the hot path for every policy that isn't a constant allow/deny.
+type boolCombinator struct {
+	Source   RegoSource
+	Op       combinatorOp
+	Children []BooleanNode
+}
+
+// And returns a BooleanNode that is true only if every child is true.
+func And(source RegoSource, children ...BooleanNode) BooleanNode {
+	return boolCombinator{Source: source, Op: combinatorAnd, Children: children}
+}
+
+// Or returns a BooleanNode that is true if any child is true.
+func Or(source RegoSource, children ...BooleanNode) BooleanNode {
+	return boolCombinator{Source: source, Op: combinatorOr, Children: children}
+}
+
+func (boolCombinator) IsBooleanNode() {}
+func (boolCombinator) UseAs() Node    { return boolCombinator{} }
+
+func (c boolCombinator) SQLString(cfg *SQLGenerator) string {
+	if len(c.Children) == 0 {
+		if c.Op == combinatorAnd {
+			return "true"
+		}
+		return "false"
+	}
+
+	parts := make([]string, 0, len(c.Children))
+	for _, child := range c.Children {
+		parts = append(parts, child.SQLString(cfg))
+	}
+
+	joined := strings.Join(parts, fmt.Sprintf(" %s ", c.Op))
+	if len(c.Children) == 1 {
+		return joined
+	}
+	// Parenthesize whenever we combine more than one child so this can be
+	// safely nested inside a larger AND/OR chain without SQL's AND-over-OR
+	// precedence silently changing the meaning.
+	return "(" + joined + ")"
+}
+
+func (c boolCombinator) MatchValue(obj map[string]any) (any, error) {
+	result := c.Op == combinatorAnd
+	for _, child := range c.Children {
+		v, err := matchValue(child, obj)
+		if err != nil {
+			return nil, fmt.Errorf("child %T: %w", child, err)
+		}
+
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected boolean child, got %T", v)
+		}
+
+		if c.Op == combinatorAnd {
+			result = result && b
+		} else {
+			result = result || b
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,165 @@
+package sqlast
+
+import "strings"
+
+// Dialect abstracts the SQL-flavor-specific formatting that node SQLString
+// implementations need, so the rest of sqlast can stay database-agnostic.
+// Every SQLGenerator carries one; nodes should format through cfg.Dialect
+// rather than hardcoding a particular database's syntax.
+type Dialect interface {
+	// QuoteString escapes and quotes a raw string value, e.g. `bob` ->
+	// `'bob'`.
+	QuoteString(value string) string
+	// CastToText wraps expr so its result is compared/rendered as text.
+	CastToText(expr string) string
+	// ArrayLiteral renders a literal array out of already-formatted
+	// element expressions, e.g. ["'a'", "'b'"] -> `ARRAY['a', 'b']`.
+	ArrayLiteral(elems []string) string
+	// MemberOf renders "elem is a member of arr", e.g. for `x in [...]`.
+	MemberOf(elem, arr string) string
+	// ArrayOverlap renders "a and b share at least one element", e.g. for
+	// `x[_] in [...]` against an array-typed column.
+	ArrayOverlap(a, b string) string
+	// JSONBPath renders access into a JSON/JSONB column at path, e.g.
+	// `col` + ["name"] -> `col->'name'`.
+	JSONBPath(col string, path []string) string
+	// JSONBContains renders "col contains the given JSON literal", e.g. for
+	// comparing an AstObject against a JSON/JSONB column.
+	JSONBContains(col, jsonLiteral string) string
+	// JSONBKeyAsText renders "the value at key in col, as text", e.g. for
+	// the per-key equality fallback when containment can't be used.
+	JSONBKeyAsText(col, key string) string
+}
+
+var _ Dialect = PostgresDialect{}
+var _ Dialect = MySQLDialect{}
+var _ Dialect = SQLiteDialect{}
+
+// PostgresDialect is the original, Postgres-flavored behavior this package
+// shipped with before Dialect existed.
+type PostgresDialect struct{}
+
+func (PostgresDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func (PostgresDialect) CastToText(expr string) string {
+	return expr + " :: text"
+}
+
+func (PostgresDialect) ArrayLiteral(elems []string) string {
+	return "ARRAY[" + strings.Join(elems, ", ") + "]"
+}
+
+func (PostgresDialect) MemberOf(elem, arr string) string {
+	return elem + " = ANY(" + arr + ")"
+}
+
+func (PostgresDialect) ArrayOverlap(a, b string) string {
+	return a + " && " + b
+}
+
+func (d PostgresDialect) JSONBPath(col string, path []string) string {
+	if len(path) == 0 {
+		return col
+	}
+	quoted := make([]string, 0, len(path))
+	for _, p := range path {
+		quoted = append(quoted, d.QuoteString(p))
+	}
+	return col + "->" + strings.Join(quoted, "->")
+}
+
+func (d PostgresDialect) JSONBContains(col, jsonLiteral string) string {
+	return col + " @> " + d.QuoteString(jsonLiteral) + "::jsonb"
+}
+
+func (PostgresDialect) JSONBKeyAsText(col, key string) string {
+	return col + "->>" + key
+}
+
+// MySQLDialect targets MySQL/MariaDB, which has no native array type and no
+// `&&` overlap operator, so arrays and membership are modeled with JSON
+// functions instead.
+type MySQLDialect struct{}
+
+func (MySQLDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func (MySQLDialect) CastToText(expr string) string {
+	return "CAST(" + expr + " AS CHAR)"
+}
+
+func (MySQLDialect) ArrayLiteral(elems []string) string {
+	return "JSON_ARRAY(" + strings.Join(elems, ", ") + ")"
+}
+
+func (MySQLDialect) MemberOf(elem, arr string) string {
+	return "JSON_CONTAINS(" + arr + ", " + elem + ")"
+}
+
+func (MySQLDialect) ArrayOverlap(a, b string) string {
+	return "JSON_OVERLAPS(" + a + ", " + b + ")"
+}
+
+func (MySQLDialect) JSONBPath(col string, path []string) string {
+	if len(path) == 0 {
+		return col
+	}
+	return "JSON_EXTRACT(" + col + ", '$." + strings.Join(path, ".") + "')"
+}
+
+func (d MySQLDialect) JSONBContains(col, jsonLiteral string) string {
+	return "JSON_CONTAINS(" + col + ", " + d.QuoteString(jsonLiteral) + ")"
+}
+
+func (MySQLDialect) JSONBKeyAsText(col, key string) string {
+	return "JSON_UNQUOTE(JSON_EXTRACT(" + col + ", CONCAT('$.', " + key + ")))"
+}
+
+// SQLiteDialect targets SQLite, which (like MySQL) has no native array type
+// and relies on its json1 extension for membership/path access.
+type SQLiteDialect struct{}
+
+func (SQLiteDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func (SQLiteDialect) CastToText(expr string) string {
+	return "CAST(" + expr + " AS TEXT)"
+}
+
+func (SQLiteDialect) ArrayLiteral(elems []string) string {
+	return "json_array(" + strings.Join(elems, ", ") + ")"
+}
+
+func (SQLiteDialect) MemberOf(elem, arr string) string {
+	return elem + " IN (SELECT value FROM json_each(" + arr + "))"
+}
+
+func (SQLiteDialect) ArrayOverlap(a, b string) string {
+	return "EXISTS (SELECT 1 FROM json_each(" + a + ") WHERE value IN (SELECT value FROM json_each(" + b + ")))"
+}
+
+func (SQLiteDialect) JSONBPath(col string, path []string) string {
+	if len(path) == 0 {
+		return col
+	}
+	return "json_extract(" + col + ", '$." + strings.Join(path, ".") + "')"
+}
+
+func (d SQLiteDialect) JSONBContains(col, jsonLiteral string) string {
+	// SQLite has no containment operator, so check containment directly:
+	// col contains jsonLiteral iff there's no key in jsonLiteral whose value
+	// in col differs (`IS NOT` is SQLite's NULL-safe comparison, so a
+	// missing key in col - json_extract returning NULL - also counts as a
+	// mismatch unless the literal's value is itself NULL). This allows col
+	// to have extra keys beyond jsonLiteral's, matching Postgres's `@>` and
+	// MySQL's JSON_CONTAINS.
+	return "NOT EXISTS (SELECT 1 FROM json_each(" + d.QuoteString(jsonLiteral) + ") WHERE json_extract(" + col + ", '$.' || key) IS NOT value)"
+}
+
+func (SQLiteDialect) JSONBKeyAsText(col, key string) string {
+	return "json_extract(" + col + ", '$.' || " + key + ")"
+}
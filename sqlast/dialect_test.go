@@ -0,0 +1,73 @@
+package sqlast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialectJSONBContains(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect Dialect
+		want    string
+	}{
+		{"postgres", PostgresDialect{}, `authors @> '{"name":"bob"}'::jsonb`},
+		{"mysql", MySQLDialect{}, `JSON_CONTAINS(authors, '{"name":"bob"}')`},
+		{"sqlite", SQLiteDialect{}, `NOT EXISTS (SELECT 1 FROM json_each('{"name":"bob"}') WHERE json_extract(authors, '$.' || key) IS NOT value)`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.dialect.JSONBContains("authors", `{"name":"bob"}`))
+		})
+	}
+}
+
+// TestObjectEqualsContainmentAcrossDialects exercises AstObject.EqualsSQLString
+// through each Dialect to confirm the containment expression is built from
+// cfg.Dialect rather than hardcoded Postgres syntax.
+func TestObjectEqualsContainmentAcrossDialects(t *testing.T) {
+	dialects := []Dialect{PostgresDialect{}, MySQLDialect{}, SQLiteDialect{}}
+
+	for _, dialect := range dialects {
+		obj := Object("", nil, ObjectEntry{Key: String("name"), Value: String("bob")})
+		col := Variable("authors")
+
+		cfg := NewSQLGenerator()
+		cfg.Dialect = dialect
+		sql, err := obj.(AstObject).EqualsSQLString(cfg, false, col)
+		require.NoError(t, err)
+		require.Equal(t, dialect.JSONBContains("authors", `{"name":"bob"}`), sql)
+	}
+}
+
+// TestDialectJSONBContainsEscapesQuotes guards against embedding a JSON
+// literal containing a single quote (e.g. from a string value like "O'Brien")
+// directly into the single-quoted SQL string JSONBContains builds, which
+// would terminate that string literal early and produce broken/unsafe SQL.
+func TestDialectJSONBContainsEscapesQuotes(t *testing.T) {
+	jsonLiteral := `{"name":"O'Brien"}`
+
+	cases := []struct {
+		name    string
+		dialect Dialect
+		want    string
+	}{
+		{"postgres", PostgresDialect{}, `authors @> '{"name":"O''Brien"}'::jsonb`},
+		{"mysql", MySQLDialect{}, `JSON_CONTAINS(authors, '{"name":"O''Brien"}')`},
+		{"sqlite", SQLiteDialect{}, `NOT EXISTS (SELECT 1 FROM json_each('{"name":"O''Brien"}') WHERE json_extract(authors, '$.' || key) IS NOT value)`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.dialect.JSONBContains("authors", jsonLiteral))
+		})
+	}
+}
+
+func TestDialectMemberOf(t *testing.T) {
+	require.Equal(t, "x = ANY(arr)", PostgresDialect{}.MemberOf("x", "arr"))
+	require.Equal(t, "JSON_CONTAINS(arr, x)", MySQLDialect{}.MemberOf("x", "arr"))
+	require.Equal(t, "x IN (SELECT value FROM json_each(arr))", SQLiteDialect{}.MemberOf("x", "arr"))
+}
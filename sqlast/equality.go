@@ -2,6 +2,8 @@ package sqlast
 
 import (
 	"fmt"
+
+	"github.com/open-policy-agent/opa/ast"
 )
 
 // SupportsEquality is an interface that can be implemented by types that
@@ -18,6 +20,11 @@ var _ Node = equality{}
 var _ SupportsEquality = equality{}
 
 type equality struct {
+	Source RegoSource
+	// Location is the position of the comparison within the original Rego
+	// module, for CompileError diagnostics.
+	Location *ast.Location
+
 	Left  Node
 	Right Node
 
@@ -26,11 +33,13 @@ type equality struct {
 	Not bool
 }
 
-func Equality(notEquals bool, a, b Node) BooleanNode {
+func Equality(source RegoSource, location *ast.Location, notEquals bool, a, b Node) BooleanNode {
 	return equality{
-		Left:  a,
-		Right: b,
-		Not:   notEquals,
+		Source:   source,
+		Location: location,
+		Left:     a,
+		Right:    b,
+		Not:      notEquals,
 	}
 }
 
@@ -54,7 +63,12 @@ func (e equality) SQLString(cfg *SQLGenerator) string {
 		}
 	}
 
-	cfg.AddError(fmt.Errorf("unsupported equality: %T %s %T", e.Left, equalsOp(e.Not), e.Right))
+	cfg.AddError(CompileError{
+		Source:      e.Source,
+		OPALocation: e.Location,
+		Kind:        KindUnsupported,
+		Underlying:  fmt.Errorf("unsupported equality: %T %s %T", e.Left, equalsOp(e.Not), e.Right),
+	})
 	return "EqualityError"
 }
 
@@ -0,0 +1,55 @@
+package sqlast
+
+import "fmt"
+
+// SQLGenerator carries the state accumulated while walking a sqlast tree and
+// rendering it to a SQL string.
+type SQLGenerator struct {
+	// Parameterized, when true, causes literal values (strings, numbers,
+	// arrays of literals, ...) to be emitted as ordered placeholders ($1,
+	// $2, ...) instead of being inlined into the SQL text. This lets a
+	// caller prepare the resulting statement once and re-execute it with
+	// different Args, rather than re-planning the query every time.
+	Parameterized bool
+
+	// Args holds the literal values registered via Placeholder, in the
+	// order their placeholders were emitted. It is only populated when
+	// Parameterized is true.
+	Args []any
+
+	// Dialect controls how nodes render SQL-flavor-specific constructs
+	// (array literals, membership checks, JSON access, ...). Defaults to
+	// PostgresDialect, which matches this package's original behavior.
+	Dialect Dialect
+
+	errs []error
+}
+
+func NewSQLGenerator() *SQLGenerator {
+	return &SQLGenerator{Dialect: PostgresDialect{}}
+}
+
+// AddError records a non-fatal error encountered while generating SQL. We
+// keep walking the tree after an error so we can report every problem in a
+// single pass, rather than aborting on the first one.
+func (cfg *SQLGenerator) AddError(err error) {
+	cfg.errs = append(cfg.errs, err)
+}
+
+func (cfg *SQLGenerator) Errors() []error {
+	return cfg.errs
+}
+
+// Placeholder registers a literal value and returns the SQL text that should
+// be emitted in its place. In the default (non-parameterized) mode this is
+// just literal, already formatted as valid SQL by the caller. In
+// parameterized mode, value is appended to Args and an ordered "$N"
+// placeholder is returned instead.
+func (cfg *SQLGenerator) Placeholder(literal string, value any) string {
+	if !cfg.Parameterized {
+		return literal
+	}
+
+	cfg.Args = append(cfg.Args, value)
+	return fmt.Sprintf("$%d", len(cfg.Args))
+}
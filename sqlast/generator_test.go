@@ -0,0 +1,81 @@
+package sqlast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlaceholderNonParameterized confirms the default (non-parameterized)
+// mode inlines the literal text and never populates Args.
+func TestPlaceholderNonParameterized(t *testing.T) {
+	cfg := NewSQLGenerator()
+	require.Equal(t, "'bob'", cfg.Placeholder("'bob'", "bob"))
+	require.Empty(t, cfg.Args)
+}
+
+// TestPlaceholderParameterized confirms placeholders are numbered in emission
+// order and Args holds the matching values in that same order.
+func TestPlaceholderParameterized(t *testing.T) {
+	cfg := NewSQLGenerator()
+	cfg.Parameterized = true
+
+	require.Equal(t, "$1", cfg.Placeholder("'bob'", "bob"))
+	require.Equal(t, "$2", cfg.Placeholder("5", 5))
+	require.Equal(t, []any{"bob", 5}, cfg.Args)
+}
+
+// TestParameterizedStringLiteral exercises AstString.SQLString end to end
+// under Parameterized, confirming the rendered SQL references the same
+// placeholder number as the value's position in Args.
+func TestParameterizedStringLiteral(t *testing.T) {
+	cfg := NewSQLGenerator()
+	cfg.Parameterized = true
+
+	sql := String("bob").SQLString(cfg)
+	require.Equal(t, "$1", sql)
+	require.Equal(t, []any{"bob"}, cfg.Args)
+}
+
+// TestParameterizedEquality confirms each literal operand in a compiled
+// equality gets its own ordered placeholder, matching the position its value
+// occupies in Args.
+func TestParameterizedEquality(t *testing.T) {
+	eq := Equality("", nil, false, Variable("name"), String("bob"))
+
+	cfg := NewSQLGenerator()
+	cfg.Parameterized = true
+	sql := eq.SQLString(cfg)
+	require.Equal(t, "name = $1", sql)
+	require.Equal(t, []any{"bob"}, cfg.Args)
+}
+
+// TestParameterizedArray confirms every element of an array literal is
+// parameterized independently, in element order.
+func TestParameterizedArray(t *testing.T) {
+	arr, err := Array("", String("edit"), String("*"))
+	require.NoError(t, err)
+
+	cfg := NewSQLGenerator()
+	cfg.Parameterized = true
+	sql := arr.SQLString(cfg)
+	require.Equal(t, "ARRAY[$1, $2]", sql)
+	require.Equal(t, []any{"edit", "*"}, cfg.Args)
+}
+
+// TestParameterizedMultipleLiteralsOrdered confirms placeholder numbers stay
+// in emission order across multiple nodes sharing one SQLGenerator, as
+// AND'd together by a boolCombinator.
+func TestParameterizedMultipleLiteralsOrdered(t *testing.T) {
+	and := And("",
+		Equality("", nil, false, Variable("name"), String("bob")),
+		Equality("", nil, false, Variable("age"), Number("", json.Number("5"))),
+	)
+
+	cfg := NewSQLGenerator()
+	cfg.Parameterized = true
+	sql := and.SQLString(cfg)
+	require.Equal(t, "(name = $1 AND age = $2)", sql)
+	require.Equal(t, []any{"bob", json.Number("5")}, cfg.Args)
+}
@@ -0,0 +1,209 @@
+package sqlast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SupportsMatch is implemented by nodes that can evaluate themselves
+// in-memory against a concrete object, instead of rendering to SQL. This is
+// the extension point that lets the top-level Prepare API's Matches method
+// check a single object using the exact same compiled predicate that
+// filters rows in SQL, so the two code paths can't disagree.
+type SupportsMatch interface {
+	// MatchValue returns this node's value given obj: a literal returns
+	// itself, a boolean expression returns a bool, and so on.
+	MatchValue(obj map[string]any) (any, error)
+}
+
+// Match walks node against obj and returns the boolean result. node must
+// ultimately resolve to a bool; anything else is an error.
+func Match(node BooleanNode, obj map[string]any) (bool, error) {
+	v, err := matchValue(node, obj)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression did not evaluate to a boolean, got %T", v)
+	}
+
+	return b, nil
+}
+
+func matchValue(n Node, obj map[string]any) (any, error) {
+	m, ok := n.(SupportsMatch)
+	if !ok {
+		return nil, fmt.Errorf("%T does not support in-memory matching", n)
+	}
+	return m.MatchValue(obj)
+}
+
+func (e equality) MatchValue(obj map[string]any) (any, error) {
+	// Object equality renders as JSONB containment in the SQL path (the
+	// other side may have extra keys beyond the literal's), so mirror that
+	// here too instead of falling through to the exact-value comparison
+	// below, which would require the other side to match key-for-key.
+	if litObj, ok := e.Left.(AstObject); ok {
+		return litObj.containsMatchValue(e.Not, e.Right, obj)
+	}
+	if litObj, ok := e.Right.(AstObject); ok {
+		return litObj.containsMatchValue(e.Not, e.Left, obj)
+	}
+
+	left, err := matchValue(e.Left, obj)
+	if err != nil {
+		return nil, fmt.Errorf("left side: %w", err)
+	}
+
+	right, err := matchValue(e.Right, obj)
+	if err != nil {
+		return nil, fmt.Errorf("right side: %w", err)
+	}
+
+	eq := fmt.Sprint(left) == fmt.Sprint(right)
+	if e.Not {
+		return !eq, nil
+	}
+	return eq, nil
+}
+
+func (o ordering) MatchValue(obj map[string]any) (any, error) {
+	left, err := matchValue(o.Left, obj)
+	if err != nil {
+		return nil, fmt.Errorf("left side: %w", err)
+	}
+
+	right, err := matchValue(o.Right, obj)
+	if err != nil {
+		return nil, fmt.Errorf("right side: %w", err)
+	}
+
+	// Mirror AstString.OrderingSQLString, which supports lexicographic
+	// comparisons in the SQL path - without this, Matches and the rendered
+	// SQL would disagree on whether a string range comparison is even valid.
+	if ls, lok := left.(string); lok {
+		rs, rok := right.(string)
+		if !rok {
+			return nil, fmt.Errorf("ordering %s requires operands of the same type, got %T and %T", o.Op, left, right)
+		}
+		cmp := strings.Compare(ls, rs)
+		return compareOrdering(o.Op, cmp < 0, cmp == 0)
+	}
+
+	lf, lok := toFloat(left)
+	rf, rok := toFloat(right)
+	if !lok || !rok {
+		return nil, fmt.Errorf("ordering %s requires numeric or string operands, got %T and %T", o.Op, left, right)
+	}
+
+	return compareOrdering(o.Op, lf < rf, lf == rf)
+}
+
+// compareOrdering turns a less-than/equal relation into the right boolean
+// for op, so numeric and lexicographic comparisons can share one switch.
+func compareOrdering(op OrderingOp, less, equal bool) (any, error) {
+	switch op {
+	case OrderingLessThan:
+		return less, nil
+	case OrderingLessThanOrEqual:
+		return less || equal, nil
+	case OrderingGreaterThan:
+		return !less && !equal, nil
+	case OrderingGreaterThanOrEqual:
+		return !less, nil
+	default:
+		return nil, fmt.Errorf("unknown ordering operator %q", op)
+	}
+}
+
+func (n AstNumber) MatchValue(obj map[string]any) (any, error) {
+	f, err := n.Value.Float64()
+	if err != nil {
+		return nil, fmt.Errorf("number %q: %w", n.Value, err)
+	}
+	return f, nil
+}
+
+func (o AstObject) MatchValue(obj map[string]any) (any, error) {
+	m := make(map[string]any, len(o.Entries))
+	for _, entry := range o.Entries {
+		key, err := matchValue(entry.Key, obj)
+		if err != nil {
+			return nil, fmt.Errorf("key: %w", err)
+		}
+		keyStr, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("object key must be a string, got %T", key)
+		}
+
+		value, err := matchValue(entry.Value, obj)
+		if err != nil {
+			return nil, fmt.Errorf("value for key %q: %w", keyStr, err)
+		}
+		m[keyStr] = value
+	}
+	return m, nil
+}
+
+// containsMatchValue is the in-memory analog of the JSONB containment check
+// EqualsSQLString renders in SQL: true if other resolves to a map holding
+// at least the keys/values in o (it may hold more).
+func (o AstObject) containsMatchValue(not bool, other Node, obj map[string]any) (any, error) {
+	actual, err := matchValue(other, obj)
+	if err != nil {
+		return nil, fmt.Errorf("other side: %w", err)
+	}
+
+	actualMap, ok := actual.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a map to check containment against, got %T", actual)
+	}
+
+	contains := true
+	for _, entry := range o.Entries {
+		key, err := matchValue(entry.Key, obj)
+		if err != nil {
+			return nil, fmt.Errorf("key: %w", err)
+		}
+		keyStr, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("object key must be a string, got %T", key)
+		}
+
+		want, err := matchValue(entry.Value, obj)
+		if err != nil {
+			return nil, fmt.Errorf("value for key %q: %w", keyStr, err)
+		}
+
+		got, ok := actualMap[keyStr]
+		if !ok || fmt.Sprint(got) != fmt.Sprint(want) {
+			contains = false
+			break
+		}
+	}
+
+	if not {
+		return !contains, nil
+	}
+	return contains, nil
+}
+
+func (v AstVar) MatchValue(obj map[string]any) (any, error) {
+	return nil, fmt.Errorf("local variable %q is not bound to a value", v.Name)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
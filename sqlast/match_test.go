@@ -0,0 +1,81 @@
+package sqlast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderingMatchValueNumeric(t *testing.T) {
+	ord := Ordering("", nil, OrderingGreaterThan, Variable("age"), Number("", json.Number("5")))
+	matches, err := Match(ord, map[string]any{"age": 10})
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = Match(ord, map[string]any{"age": 3})
+	require.NoError(t, err)
+	require.False(t, matches)
+}
+
+// TestOrderingMatchValueString guards against Matches and the SQL path
+// disagreeing on whether a string range comparison (which
+// AstString.OrderingSQLString explicitly supports) is even valid.
+func TestOrderingMatchValueString(t *testing.T) {
+	ord := Ordering("", nil, OrderingGreaterThan, Variable("status"), String("archived"))
+
+	cfg := NewSQLGenerator()
+	sql := ord.SQLString(cfg)
+	require.Empty(t, cfg.Errors())
+	require.Equal(t, "status > 'archived'", sql)
+
+	matches, err := Match(ord, map[string]any{"status": "banana"})
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = Match(ord, map[string]any{"status": "aardvark"})
+	require.NoError(t, err)
+	require.False(t, matches)
+}
+
+func TestOrderingMatchValueMixedTypesErrors(t *testing.T) {
+	ord := Ordering("", nil, OrderingGreaterThan, Variable("status"), Number("", json.Number("5")))
+	_, err := Match(ord, map[string]any{"status": "pending"})
+	require.Error(t, err)
+}
+
+// TestEqualityMatchValueObjectContainment guards against equality.MatchValue
+// requiring exact map equality, when the SQL path it must agree with
+// (AstObject.EqualsSQLString) renders JSONB containment: a column value with
+// extra keys beyond the literal's should still match.
+func TestEqualityMatchValueObjectContainment(t *testing.T) {
+	eq := Equality("", nil, false,
+		Object("", nil, ObjectEntry{Key: String("name"), Value: String("bob")}),
+		Variable("authors"),
+	)
+
+	matches, err := Match(eq, map[string]any{
+		"authors": map[string]any{"name": "bob", "age": 5},
+	})
+	require.NoError(t, err)
+	require.True(t, matches, "containment should match even with extra keys on the actual value")
+
+	matches, err = Match(eq, map[string]any{
+		"authors": map[string]any{"name": "alice", "age": 5},
+	})
+	require.NoError(t, err)
+	require.False(t, matches)
+}
+
+func TestEqualityMatchValueObjectContainmentNegated(t *testing.T) {
+	eq := Equality("", nil, true,
+		Object("", nil, ObjectEntry{Key: String("name"), Value: String("bob")}),
+		Variable("authors"),
+	)
+
+	matches, err := Match(eq, map[string]any{
+		"authors": map[string]any{"name": "alice"},
+	})
+	require.NoError(t, err)
+	require.True(t, matches)
+}
@@ -23,7 +23,7 @@ func (AstNumber) UseAs() Node { return AstNumber{} }
 
 func (n AstNumber) SQLString(cfg *SQLGenerator) string {
 	// TODO: Verify that this is a valid AstNumber in sql
-	return n.Value.String()
+	return cfg.Placeholder(n.Value.String(), n.Value)
 }
 
 func (n AstNumber) EqualsSQLString(cfg *SQLGenerator, not bool, other Node) (string, error) {
@@ -34,3 +34,12 @@ func (n AstNumber) EqualsSQLString(cfg *SQLGenerator, not bool, other Node) (str
 
 	return "", fmt.Errorf("unsupported equality: %T %s %T", n, equalsOp(not), other)
 }
+
+func (n AstNumber) OrderingSQLString(cfg *SQLGenerator, op OrderingOp, other Node) (string, error) {
+	switch other.UseAs().(type) {
+	case AstNumber:
+		return basicSQLOrdering(cfg, op, n, other), nil
+	}
+
+	return "", fmt.Errorf("unsupported ordering: %T %s %T", n, op, other)
+}
@@ -0,0 +1,202 @@
+package sqlast
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/open-policy-agent/opa/ast"
+)
+
+// ObjectEntry is a single key/value pair within an AstObject. Key is almost
+// always an AstString, but we keep it a Node since Rego technically allows
+// any hashable term as an object key.
+type ObjectEntry struct {
+	Key   Node
+	Value Node
+}
+
+// AstObject represents an object/map term, e.g. the RHS of
+// `input.post.authors == {"name": "bob"}`. Objects have no scalar SQL
+// representation of their own; they only make sense when compared against a
+// column, at which point they render as a JSONB containment check (or, when
+// one of the entries isn't a plain literal and so can't be written into a
+// JSON literal, as an AND of per-key equalities).
+type AstObject struct {
+	Source RegoSource
+	// Location is the position of the object term within the original Rego
+	// module, for CompileError diagnostics.
+	Location *ast.Location
+	Entries  []ObjectEntry
+}
+
+func Object(source RegoSource, location *ast.Location, entries ...ObjectEntry) Node {
+	return AstObject{Source: source, Location: location, Entries: entries}
+}
+
+func (AstObject) UseAs() Node { return AstObject{} }
+
+func (o AstObject) SQLString(cfg *SQLGenerator) string {
+	cfg.AddError(CompileError{
+		Source:      o.Source,
+		OPALocation: o.Location,
+		Kind:        KindUnsupported,
+		Underlying:  fmt.Errorf("object has no direct SQL representation; it must be compared against a column"),
+	})
+	return "ObjectError"
+}
+
+// EqualsSQLString lets an AstObject participate in an equality expression.
+// We render it as a JSONB containment check against whatever the other side
+// resolves to, e.g. `authors @> '{"name":"bob"}'::jsonb`.
+func (o AstObject) EqualsSQLString(cfg *SQLGenerator, not bool, other Node) (string, error) {
+	jsonLiteral, err := o.jsonString(cfg)
+	if err != nil {
+		// We couldn't render one of the values as JSON (e.g. it's itself a
+		// column reference). Fall back to ANDing together per-key equality
+		// checks against the other side instead of one containment check.
+		return o.perKeyEqualitySQLString(cfg, not, other)
+	}
+
+	contains := cfg.Dialect.JSONBContains(other.SQLString(cfg), jsonLiteral)
+	if not {
+		// Containment operators generally have no negation sugar the way
+		// LIKE/IN/BETWEEN do, so we have to negate the whole expression.
+		return fmt.Sprintf("NOT (%s)", contains), nil
+	}
+	return contains, nil
+}
+
+// perKeyEqualitySQLString ANDs together an equality per object key, e.g.
+// `(other->>'name' = 'bob' AND other->>'age' = '5')`, or, when not is set,
+// ORs together the per-key inequalities. EqualsSQLString uses it when the
+// object can't be rendered as a single JSON literal.
+func (o AstObject) perKeyEqualitySQLString(cfg *SQLGenerator, not bool, other Node) (string, error) {
+	if len(o.Entries) == 0 {
+		return "", fmt.Errorf("empty object %q has no per-key equality form", o.Source)
+	}
+
+	var parts []string
+	for _, entry := range o.Entries {
+		parts = append(parts, fmt.Sprintf("%s %s %s",
+			cfg.Dialect.JSONBKeyAsText(other.SQLString(cfg), entry.Key.SQLString(cfg)),
+			equalsOp(not),
+			entry.Value.SQLString(cfg),
+		))
+	}
+
+	joiner := " AND "
+	if not {
+		joiner = " OR "
+	}
+
+	joined := strings.Join(parts, joiner)
+	if len(parts) == 1 {
+		return joined, nil
+	}
+	// Parenthesize whenever we join more than one key so this can be safely
+	// embedded inside a larger AND chain without AND-over-OR precedence
+	// silently changing the meaning (most relevant for the `not`/OR case).
+	return "(" + joined + ")", nil
+}
+
+// jsonString renders the object as a JSON literal, e.g. `{"name":"bob"}`, via
+// nodeToJSON rather than each entry's SQLString - SQLString registers a
+// placeholder with cfg under cfg.Parameterized, and we'd otherwise burn one
+// for every literal in here without ever referencing it in the emitted SQL.
+// It errors if any entry isn't a plain literal (e.g. a nested column
+// reference), in which case the caller should fall back to
+// perKeyEqualitySQLString instead.
+func (o AstObject) jsonString(cfg *SQLGenerator) (string, error) {
+	return nodeToJSON(o)
+}
+
+// nodeToJSON renders a literal node as JSON directly from its Go value,
+// without going through SQLString, so building a JSON literal never
+// registers a cfg.Parameterized placeholder. It errors on anything that
+// isn't a plain literal (e.g. a column reference), which signals the
+// caller should fall back to a per-key comparison instead.
+func nodeToJSON(n Node) (string, error) {
+	switch v := n.(type) {
+	case AstString:
+		b, err := json.Marshal(v.Value)
+		if err != nil {
+			return "", fmt.Errorf("string: %w", err)
+		}
+		return string(b), nil
+	case AstNumber:
+		return v.Value.String(), nil
+	case AstBoolean:
+		if v.Value {
+			return "true", nil
+		}
+		return "false", nil
+	case AstObject:
+		var b strings.Builder
+		b.WriteString("{")
+		for i, entry := range v.Entries {
+			if i != 0 {
+				b.WriteString(",")
+			}
+			key, err := nodeToJSON(entry.Key)
+			if err != nil {
+				return "", fmt.Errorf("key: %w", err)
+			}
+			value, err := nodeToJSON(entry.Value)
+			if err != nil {
+				return "", fmt.Errorf("value for key %s: %w", key, err)
+			}
+			b.WriteString(key)
+			b.WriteString(":")
+			b.WriteString(value)
+		}
+		b.WriteString("}")
+		return b.String(), nil
+	case AstArray:
+		var b strings.Builder
+		b.WriteString("[")
+		for i, elem := range v.Elems {
+			if i != 0 {
+				b.WriteString(",")
+			}
+			s, err := nodeToJSON(elem)
+			if err != nil {
+				return "", fmt.Errorf("element %d: %w", i, err)
+			}
+			b.WriteString(s)
+		}
+		b.WriteString("]")
+		return b.String(), nil
+	}
+
+	return "", fmt.Errorf("%T is not a JSON-renderable literal", n)
+}
+
+// AstVar represents a bare, unresolved local variable term, e.g. the `x` in
+// `x := input.posts[_]; x.author == input.user`. We don't yet unify local
+// variable bindings with the ref they're assigned from, so this node exists
+// solely to give convertTerm something to return instead of erroring
+// immediately; any attempt to render it is a compile error.
+type AstVar struct {
+	Source RegoSource
+	// Location is the position of the variable term within the original
+	// Rego module, for CompileError diagnostics.
+	Location *ast.Location
+	Name     string
+}
+
+func Var(source RegoSource, location *ast.Location, name string) Node {
+	return AstVar{Source: source, Location: location, Name: name}
+}
+
+func (AstVar) UseAs() Node { return AstVar{} }
+
+func (v AstVar) SQLString(cfg *SQLGenerator) string {
+	cfg.AddError(CompileError{
+		Source:      v.Source,
+		OPALocation: v.Location,
+		Kind:        KindUnsupported,
+		Underlying:  fmt.Errorf("local variable %q is not bound to a column", v.Name),
+	})
+	return "VarError"
+}
@@ -0,0 +1,75 @@
+package sqlast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectEqualsContainment(t *testing.T) {
+	obj := Object("", nil, ObjectEntry{Key: String("name"), Value: String("bob")})
+	col := Variable("authors")
+
+	cfg := NewSQLGenerator()
+	sql, err := obj.(AstObject).EqualsSQLString(cfg, false, col)
+	require.NoError(t, err)
+	require.Equal(t, `authors @> '{"name":"bob"}'::jsonb`, sql)
+	require.Empty(t, cfg.Args)
+}
+
+func TestObjectEqualsContainmentNegated(t *testing.T) {
+	obj := Object("", nil, ObjectEntry{Key: String("name"), Value: String("bob")})
+	col := Variable("authors")
+
+	cfg := NewSQLGenerator()
+	sql, err := obj.(AstObject).EqualsSQLString(cfg, true, col)
+	require.NoError(t, err)
+	require.Equal(t, `NOT (authors @> '{"name":"bob"}'::jsonb)`, sql)
+}
+
+// TestObjectEqualsContainmentParameterized guards against the bug where
+// jsonString built its JSON literal by calling each entry's SQLString, which
+// under cfg.Parameterized registers a placeholder for every literal even
+// though the JSON literal is inlined directly into the containment check
+// text - burning placeholder numbers that never appear in the emitted SQL.
+func TestObjectEqualsContainmentParameterized(t *testing.T) {
+	obj := Object("", nil, ObjectEntry{Key: String("name"), Value: String("bob")})
+	col := Variable("authors")
+
+	cfg := NewSQLGenerator()
+	cfg.Parameterized = true
+	sql, err := obj.(AstObject).EqualsSQLString(cfg, false, col)
+	require.NoError(t, err)
+	require.Equal(t, `authors @> '{"name":"bob"}'::jsonb`, sql)
+	require.Empty(t, cfg.Args, "building the JSON literal must not register any placeholders")
+}
+
+// TestObjectEqualsPerKeyFallback exercises the AND-of-per-key-equality path
+// taken when the object can't be rendered as a single JSON literal (here,
+// because the value is a column reference rather than a plain literal).
+func TestObjectEqualsPerKeyFallback(t *testing.T) {
+	obj := Object("", nil,
+		ObjectEntry{Key: String("name"), Value: Variable("other_name")},
+		ObjectEntry{Key: String("age"), Value: Number("", json.Number("5"))},
+	)
+	col := Variable("authors")
+
+	cfg := NewSQLGenerator()
+	sql, err := obj.(AstObject).EqualsSQLString(cfg, false, col)
+	require.NoError(t, err)
+	require.Equal(t, `(authors->>'name' = other_name AND authors->>'age' = 5)`, sql)
+}
+
+func TestObjectEqualsPerKeyFallbackNegated(t *testing.T) {
+	obj := Object("", nil,
+		ObjectEntry{Key: String("name"), Value: Variable("other_name")},
+		ObjectEntry{Key: String("age"), Value: Number("", json.Number("5"))},
+	)
+	col := Variable("authors")
+
+	cfg := NewSQLGenerator()
+	sql, err := obj.(AstObject).EqualsSQLString(cfg, true, col)
+	require.NoError(t, err)
+	require.Equal(t, `(authors->>'name' != other_name OR authors->>'age' != 5)`, sql)
+}
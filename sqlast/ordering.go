@@ -0,0 +1,114 @@
+package sqlast
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/ast"
+)
+
+// OrderingOp is the comparison operator used by an ordering node.
+type OrderingOp string
+
+const (
+	OrderingLessThan           OrderingOp = "<"
+	OrderingLessThanOrEqual    OrderingOp = "<="
+	OrderingGreaterThan        OrderingOp = ">"
+	OrderingGreaterThanOrEqual OrderingOp = ">="
+)
+
+// commute returns the operator that produces an equivalent comparison when
+// the left and right operands are swapped. E.g. "a < b" == "b > a".
+func (op OrderingOp) commute() OrderingOp {
+	switch op {
+	case OrderingLessThan:
+		return OrderingGreaterThan
+	case OrderingLessThanOrEqual:
+		return OrderingGreaterThanOrEqual
+	case OrderingGreaterThan:
+		return OrderingLessThan
+	case OrderingGreaterThanOrEqual:
+		return OrderingLessThanOrEqual
+	default:
+		return op
+	}
+}
+
+// SupportsOrdering is an interface that can be implemented by types that
+// support ordering comparisons with other types. As with SupportsEquality, we
+// defer to the type itself since it has the most context on how to compare
+// against another node.
+type SupportsOrdering interface {
+	// OrderingSQLString intentionally returns an error. This is so if
+	// left < right is not supported, we can try right > left.
+	OrderingSQLString(cfg *SQLGenerator, op OrderingOp, other Node) (string, error)
+}
+
+var _ BooleanNode = ordering{}
+var _ Node = ordering{}
+
+type ordering struct {
+	Source RegoSource
+	// Location is the position of the comparison within the original Rego
+	// module, for CompileError diagnostics.
+	Location *ast.Location
+
+	Left  Node
+	Right Node
+	Op    OrderingOp
+}
+
+// Ordering returns a BooleanNode comparing a and b with the given operator.
+func Ordering(source RegoSource, location *ast.Location, op OrderingOp, a, b Node) BooleanNode {
+	return ordering{
+		Source:   source,
+		Location: location,
+		Left:     a,
+		Right:    b,
+		Op:       op,
+	}
+}
+
+func (ordering) IsBooleanNode() {}
+func (ordering) UseAs() Node    { return ordering{} }
+
+func (o ordering) SQLString(cfg *SQLGenerator) string {
+	// Orderings can be flipped by commuting the operator, so if the left
+	// side does not know how to compare itself to the right, retry with
+	// the operands (and operator) swapped.
+	if ord, ok := o.Left.(SupportsOrdering); ok {
+		v, err := ord.OrderingSQLString(cfg, o.Op, o.Right)
+		if err == nil {
+			return v
+		}
+	}
+
+	if ord, ok := o.Right.(SupportsOrdering); ok {
+		v, err := ord.OrderingSQLString(cfg, o.Op.commute(), o.Left)
+		if err == nil {
+			return v
+		}
+	}
+
+	cfg.AddError(CompileError{
+		Source:      o.Source,
+		OPALocation: o.Location,
+		Kind:        KindUnsupported,
+		Underlying:  fmt.Errorf("unsupported ordering: %T %s %T", o.Left, o.Op, o.Right),
+	})
+	return "OrderingError"
+}
+
+func basicSQLOrdering(cfg *SQLGenerator, op OrderingOp, a, b Node) string {
+	return fmt.Sprintf("%s %s %s", a.SQLString(cfg), op, b.SQLString(cfg))
+}
+
+// OrderingSQLString implements SupportsOrdering for strings, allowing
+// lexicographic comparisons (e.g. `input.post.status > "archived"`).
+func (s AstString) OrderingSQLString(cfg *SQLGenerator, op OrderingOp, other Node) (string, error) {
+	switch other.UseAs().(type) {
+	case AstString:
+		return basicSQLOrdering(cfg, op, s, other), nil
+	}
+
+	return "", fmt.Errorf("unsupported ordering: %T %s %T", s, op, other)
+}
@@ -0,0 +1,59 @@
+package sqlast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderingSQLStringNumeric(t *testing.T) {
+	ord := Ordering("", nil, OrderingLessThanOrEqual, Variable("age"), Number("", json.Number("21")))
+
+	cfg := NewSQLGenerator()
+	sql := ord.SQLString(cfg)
+	require.Empty(t, cfg.Errors())
+	require.Equal(t, "age <= 21", sql)
+}
+
+func TestOrderingSQLStringString(t *testing.T) {
+	ord := Ordering("", nil, OrderingGreaterThan, Variable("status"), String("archived"))
+
+	cfg := NewSQLGenerator()
+	sql := ord.SQLString(cfg)
+	require.Empty(t, cfg.Errors())
+	require.Equal(t, "status > 'archived'", sql)
+}
+
+// TestOrderingSQLStringCommutes guards the retry-with-operands-swapped
+// behavior in ordering.SQLString: AstString only implements SupportsOrdering
+// against another AstString, so when the literal is on the left and the
+// column is on the right, SQLString must retry as "right commute(op) left"
+// rather than erroring out.
+func TestOrderingSQLStringCommutes(t *testing.T) {
+	ord := Ordering("", nil, OrderingLessThan, String("archived"), Variable("status"))
+
+	cfg := NewSQLGenerator()
+	sql := ord.SQLString(cfg)
+	require.Empty(t, cfg.Errors())
+	require.Equal(t, "status > 'archived'", sql)
+}
+
+// TestOrderingSQLStringUnsupported confirms that when neither operand can
+// compare itself to the other, SQLString records a CompileError carrying the
+// ordering's Location instead of panicking or silently emitting bad SQL.
+func TestOrderingSQLStringUnsupported(t *testing.T) {
+	loc := &ast.Location{Row: 7}
+	ord := Ordering("input.x > input.y", loc, OrderingGreaterThan, Variable("name"), AstBoolean{Value: true})
+
+	cfg := NewSQLGenerator()
+	cfg.Dialect = PostgresDialect{}
+	_ = ord.SQLString(cfg)
+
+	require.Len(t, cfg.Errors(), 1)
+	var compileErr CompileError
+	require.ErrorAs(t, cfg.Errors()[0], &compileErr)
+	require.Equal(t, KindUnsupported, compileErr.Kind)
+	require.Same(t, loc, compileErr.OPALocation)
+}
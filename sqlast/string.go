@@ -0,0 +1,36 @@
+package sqlast
+
+import "fmt"
+
+var _ Node = AstString{}
+var _ SupportsEquality = AstString{}
+var _ SupportsOrdering = AstString{}
+var _ SupportsMatch = AstString{}
+
+// AstString represents a literal string term, e.g. "bob".
+type AstString struct {
+	Value string
+}
+
+func String(v string) Node {
+	return AstString{Value: v}
+}
+
+func (AstString) UseAs() Node { return AstString{} }
+
+func (s AstString) SQLString(cfg *SQLGenerator) string {
+	return cfg.Placeholder(cfg.Dialect.QuoteString(s.Value), s.Value)
+}
+
+func (s AstString) EqualsSQLString(cfg *SQLGenerator, not bool, other Node) (string, error) {
+	switch other.UseAs().(type) {
+	case AstString:
+		return basicSQLEquality(cfg, not, s, other), nil
+	}
+
+	return "", fmt.Errorf("unsupported equality: %T %s %T", s, equalsOp(not), other)
+}
+
+func (s AstString) MatchValue(obj map[string]any) (any, error) {
+	return s.Value, nil
+}
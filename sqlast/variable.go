@@ -0,0 +1,140 @@
+package sqlast
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/ast"
+)
+
+// VariableMatcher is called each time convertTerm encounters an unknown
+// ref, e.g. `input.post.author`, and must turn it into a sqlast Node -
+// typically a column reference.
+type VariableMatcher interface {
+	ConvertVariable(ref ast.Ref) (Node, bool)
+}
+
+var _ Node = AstVariable{}
+var _ SupportsEquality = AstVariable{}
+var _ SupportsOrdering = AstVariable{}
+var _ SupportsMatch = AstVariable{}
+
+// AstVariable represents an unknown ref that's been resolved to a SQL
+// column expression, e.g. `input.post.author` -> `author`.
+type AstVariable struct {
+	Column string
+}
+
+func Variable(column string) Node {
+	return AstVariable{Column: column}
+}
+
+func (AstVariable) UseAs() Node { return AstVariable{} }
+
+func (v AstVariable) SQLString(cfg *SQLGenerator) string {
+	return v.Column
+}
+
+func (v AstVariable) EqualsSQLString(cfg *SQLGenerator, not bool, other Node) (string, error) {
+	// A column can be compared against any scalar literal (string, number or
+	// boolean), or against another column, so unlike the literal node types
+	// we don't narrow this to one case.
+	switch other.UseAs().(type) {
+	case AstString, AstNumber, AstBoolean, AstVariable:
+		return basicSQLEquality(cfg, not, v, other), nil
+	}
+
+	return "", fmt.Errorf("unsupported equality: %T %s %T", v, equalsOp(not), other)
+}
+
+func (v AstVariable) OrderingSQLString(cfg *SQLGenerator, op OrderingOp, other Node) (string, error) {
+	// A column can be ordered against a string or number literal or another
+	// column. Booleans have no ordering, so unlike equality they are
+	// rejected here.
+	switch other.UseAs().(type) {
+	case AstString, AstNumber, AstVariable:
+		return basicSQLOrdering(cfg, op, v, other), nil
+	}
+
+	return "", fmt.Errorf("unsupported ordering: %T %s %T", v, op, other)
+}
+
+func (v AstVariable) MatchValue(obj map[string]any) (any, error) {
+	val, ok := obj[v.Column]
+	if !ok {
+		return nil, fmt.Errorf("no value for column %q in object", v.Column)
+	}
+	return val, nil
+}
+
+// refMatcher is the smaller interface RegisterMatcher accepts: a
+// single-purpose rule that knows how to resolve one specific ref shape.
+type refMatcher interface {
+	match(ref ast.Ref) (Node, bool)
+}
+
+// stringVarMatcher resolves a ref that exactly matches path into a static
+// SQL column expression.
+type stringVarMatcher struct {
+	column string
+	path   []string
+}
+
+// StringVarMatcher returns a matcher that resolves refs matching path
+// (e.g. []string{"input", "object", "owner"}) to column.
+func StringVarMatcher(column string, path []string) refMatcher {
+	return stringVarMatcher{column: column, path: path}
+}
+
+func (m stringVarMatcher) match(ref ast.Ref) (Node, bool) {
+	if len(ref) != len(m.path) {
+		return nil, false
+	}
+
+	for i, want := range m.path {
+		if i == 0 {
+			// The first term of a ref is always the root ast.Var (e.g.
+			// "input"), not an ast.String.
+			v, ok := ref[i].Value.(ast.Var)
+			if !ok || string(v) != want {
+				return nil, false
+			}
+			continue
+		}
+
+		s, ok := ref[i].Value.(ast.String)
+		if !ok || string(s) != want {
+			return nil, false
+		}
+	}
+
+	return AstVariable{Column: m.column}, true
+}
+
+var _ VariableMatcher = (*VariableConverter)(nil)
+
+// VariableConverter is the default VariableMatcher: it tries each
+// registered matcher in turn and returns the first one that resolves the
+// ref.
+type VariableConverter struct {
+	matchers []refMatcher
+}
+
+func NewVariableConverter() *VariableConverter {
+	return &VariableConverter{}
+}
+
+// RegisterMatcher appends matchers and returns the receiver, so calls can be
+// chained off of NewVariableConverter().
+func (c *VariableConverter) RegisterMatcher(matchers ...refMatcher) *VariableConverter {
+	c.matchers = append(c.matchers, matchers...)
+	return c
+}
+
+func (c *VariableConverter) ConvertVariable(ref ast.Ref) (Node, bool) {
+	for _, m := range c.matchers {
+		if node, ok := m.match(ref); ok {
+			return node, true
+		}
+	}
+	return nil, false
+}